@@ -2,6 +2,7 @@ package snapshotsync
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"testing"
 	"testing/fstest"
@@ -239,6 +240,32 @@ func TestParseCompressedFileName(t *testing.T) {
 	require.Equal(2_000, int(f.To))
 }
 
+func TestSnapshotsDiskUsage(t *testing.T) {
+	dir, require := t.TempDir(), require.New(t)
+	write := func(name string, size int) {
+		require.NoError(os.WriteFile(filepath.Join(dir, name), make([]byte, size), 0644))
+	}
+	write(snap.SegmentFileName(0, 500_000, snap.Headers), 10)
+	write(snap.IdxFileName(0, 500_000, snap.Headers.String()), 1)
+	write(snap.SegmentFileName(500_000, 1_000_000, snap.Headers), 20)
+	write(snap.SegmentFileName(0, 500_000, snap.Bodies), 100)
+	write(snap.IdxFileName(0, 500_000, snap.Transactions2Block.String()), 3)
+	write("unknown.seg", 1000)
+	require.NoError(os.Mkdir(filepath.Join(dir, "history"), 0755))
+
+	byType, total, err := snap.DiskUsage(dir)
+	require.NoError(err)
+	require.Equal(uint64(31), byType[snap.Headers])
+	require.Equal(uint64(100), byType[snap.Bodies])
+	require.Equal(uint64(3), byType[snap.Transactions])
+	require.Equal(uint64(134), total)
+
+	byType, total, err = snap.DiskUsage(filepath.Join(dir, "not-exists"))
+	require.NoError(err)
+	require.Empty(byType)
+	require.Zero(total)
+}
+
 func BenchmarkName(b *testing.B) {
 	a := common.Address{}
 	c := a[:]
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ledgerwatch/erigon/common"
 	"github.com/ledgerwatch/erigon/turbo/snapshotsync/snapcfg"
+	"github.com/ledgerwatch/log/v3"
 	"golang.org/x/exp/slices"
 )
 
@@ -218,6 +219,36 @@ func ParseDir(dir string) (res []FileInfo, err error) {
 	return res, nil
 }
 
+// DiskUsage - size in bytes of all snapshot files in dir, by type and in total.
+// Entries which can't be stat'ed are skipped with a warning.
+func DiskUsage(dir string) (byType map[Type]uint64, total uint64, err error) {
+	byType = map[Type]uint64{}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return byType, 0, nil
+		}
+		return nil, 0, err
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		meta, err := ParseFileName(dir, f.Name())
+		if err != nil {
+			continue
+		}
+		fileInfo, err := f.Info()
+		if err != nil {
+			log.Warn("[snapshots] skip file in disk usage", "file", f.Name(), "err", err)
+			continue
+		}
+		byType[meta.T] += uint64(fileInfo.Size())
+		total += uint64(fileInfo.Size())
+	}
+	return byType, total, nil
+}
+
 func RemoveNonPreverifiedFiles(chainName, snapDir string) error {
 	preverified := snapcfg.KnownCfg(chainName, nil, nil).Preverified
 	keep := map[string]struct{}{}